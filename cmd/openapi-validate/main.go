@@ -0,0 +1,67 @@
+// Command openapi-validate builds the route table against the in-memory
+// storage backend and a throwaway auth secret, then lints the resulting
+// OpenAPI document with openapi.Lint. No database, no network, no external
+// linter -- it exists so `make openapi-validate` catches a document that
+// fails Lint's structural checks without needing CI infrastructure. Missing
+// summaries or schemas are not checked.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/bishal05das/students-api/internal/auth"
+	"github.com/bishal05das/students-api/internal/config"
+	"github.com/bishal05das/students-api/internal/httpapi"
+	"github.com/bishal05das/students-api/internal/metrics"
+	"github.com/bishal05das/students-api/internal/openapi"
+	"github.com/bishal05das/students-api/internal/storage"
+	_ "github.com/bishal05das/students-api/internal/storage/memory"
+	"github.com/bishal05das/students-api/internal/types"
+)
+
+// stubUsers satisfies storage.UserStorage just well enough to wire up the
+// auth routes; openapi-validate only reflects over the route table, it
+// never calls a handler, so the methods are never actually invoked.
+type stubUsers struct{}
+
+func (stubUsers) CreateUser(email, passwordHash, role string) (int64, error) {
+	return 0, nil
+}
+
+func (stubUsers) GetUserByEmail(email string) (types.User, error) {
+	return types.User{}, nil
+}
+
+func main() {
+	authService, err := auth.NewService(config.AuthConfig{
+		SigningMethod: "HS256",
+		Secret:        "openapi-validate",
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "openapi-validate: build auth service:", err)
+		os.Exit(1)
+	}
+
+	// Go through the same storage.Open path production uses (driver name
+	// "memory", registered by the blank import above) instead of calling
+	// memory.New() directly, so this tool actually exercises the registry.
+	store, err := storage.Open(&config.Config{Storage: config.StorageConfig{Driver: "memory"}})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "openapi-validate: open storage:", err)
+		os.Exit(1)
+	}
+
+	_, routes := httpapi.Routes(store, stubUsers{}, authService, metrics.NewRegistry(), "/metrics")
+	doc := routes.Build("students-api", "1.0.0")
+
+	errs := openapi.Lint(doc)
+	if len(errs) > 0 {
+		for _, e := range errs {
+			fmt.Fprintln(os.Stderr, "openapi-validate:", e)
+		}
+		os.Exit(1)
+	}
+
+	fmt.Printf("openapi-validate: spec OK (%d paths)\n", len(doc.Paths))
+}
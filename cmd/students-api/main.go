@@ -11,32 +11,56 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/bishal05das/students-api/internal/auth"
 	"github.com/bishal05das/students-api/internal/config"
-	"github.com/bishal05das/students-api/internal/http/handlers/student"
+	"github.com/bishal05das/students-api/internal/httpapi"
+	"github.com/bishal05das/students-api/internal/metrics"
+	"github.com/bishal05das/students-api/internal/middleware/logging"
+	"github.com/bishal05das/students-api/internal/storage"
+	_ "github.com/bishal05das/students-api/internal/storage/bolt"
+	_ "github.com/bishal05das/students-api/internal/storage/postgres"
+	_ "github.com/bishal05das/students-api/internal/storage/sqlite"
 )
 
 func main() {
 	//load config
 	cfg := config.MustLoad()
+
+	logger := slog.New(logging.NewHandler(cfg.Env))
+	slog.SetDefault(logger)
+
 	// database setup
+	store, err := storage.Open(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	//router setup
-	router := http.NewServeMux()
+	users, ok := store.(storage.UserStorage)
+	if !ok {
+		log.Fatalf("storage driver %q does not support the users/auth subsystem", cfg.Storage.Driver)
+	}
+
+	authService, err := auth.NewService(cfg.Auth)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	router.HandleFunc("POST /api/students", student.New())
+	//router setup
+	metricsRegistry := metrics.NewRegistry()
+	router, _ := httpapi.Routes(store, users, authService, metricsRegistry, cfg.MetricsPath)
 
 	//setup server
 
 	server := http.Server{
 		Addr:    cfg.Addr,
-		Handler: router,
+		Handler: metrics.Middleware(metricsRegistry, router)(logging.Middleware(logger)(router)),
 	}
 	fmt.Println("server started")
-	slog.Info("server started",slog.String("address",cfg.Addr))
+	slog.Info("server started", slog.String("address", cfg.Addr))
 
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
-    //graceful shutdown
+	//graceful shutdown
 	go func() {
 		err := server.ListenAndServe()
 		if err != nil {
@@ -49,8 +73,8 @@ func main() {
 
 	defer cancel()
 
-	if err:= server.Shutdown(ctx); err != nil {
-		slog.Error("failed to shutdown server", slog.String("error",err.Error()))
+	if err := server.Shutdown(ctx); err != nil {
+		slog.Error("failed to shutdown server", slog.String("error", err.Error()))
 	}
 
 	slog.Info(("server shutdown gracefully"))
@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/bishal05das/students-api/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var ErrInvalidToken = errors.New("invalid or expired token")
+
+type Claims struct {
+	UserId int64  `json:"user_id"`
+	Role   string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// Service issues and verifies JWTs per cfg.Auth. HS256 is the default:
+// tokens are always signed with Secret, but verification also accepts any
+// key listed in PreviousSecrets, so a secret can be rotated without
+// invalidating tokens issued under the old one. RS256 signs/verifies with
+// an RSA key pair instead and ignores PreviousSecrets.
+type Service struct {
+	cfg        config.AuthConfig
+	signingKey interface{}
+	verifyKeys []interface{}
+}
+
+func NewService(cfg config.AuthConfig) (*Service, error) {
+	switch cfg.SigningMethod {
+	case "", "HS256":
+		if cfg.Secret == "" {
+			return nil, fmt.Errorf("auth: JWT_SECRET must be set when signing_method is HS256")
+		}
+
+		verifyKeys := []interface{}{[]byte(cfg.Secret)}
+		for _, prev := range cfg.PreviousSecrets {
+			verifyKeys = append(verifyKeys, []byte(prev))
+		}
+		return &Service{cfg: cfg, signingKey: []byte(cfg.Secret), verifyKeys: verifyKeys}, nil
+
+	case "RS256":
+		priv, err := loadRSAPrivateKey(cfg.RSAPrivateKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("auth: loading RSA private key: %w", err)
+		}
+		pub, err := loadRSAPublicKey(cfg.RSAPublicKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("auth: loading RSA public key: %w", err)
+		}
+		return &Service{cfg: cfg, signingKey: priv, verifyKeys: []interface{}{pub}}, nil
+
+	default:
+		return nil, fmt.Errorf("auth: unsupported signing method %q", cfg.SigningMethod)
+	}
+}
+
+func (s *Service) method() jwt.SigningMethod {
+	if s.cfg.SigningMethod == "RS256" {
+		return jwt.SigningMethodRS256
+	}
+	return jwt.SigningMethodHS256
+}
+
+// IssueToken signs a new token carrying userId and role, always with the
+// current signing key.
+func (s *Service) IssueToken(userId int64, role string) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		UserId: userId,
+		Role:   role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.cfg.TokenTTL)),
+		},
+	}
+
+	return jwt.NewWithClaims(s.method(), claims).SignedString(s.signingKey)
+}
+
+// Verify parses and validates tokenString, trying each verification key in
+// turn so a rotated-out HS256 secret still verifies its own old tokens.
+func (s *Service) Verify(tokenString string) (*Claims, error) {
+	var lastErr error
+
+	for _, key := range s.verifyKeys {
+		claims := &Claims{}
+		token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+			return key, nil
+		}, jwt.WithValidMethods([]string{s.method().Alg()}))
+		if err == nil && token.Valid {
+			return claims, nil
+		}
+		lastErr = err
+	}
+
+	return nil, fmt.Errorf("%w: %v", ErrInvalidToken, lastErr)
+}
+
+func loadRSAPrivateKey(path string) (interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return jwt.ParseRSAPrivateKeyFromPEM(data)
+}
+
+func loadRSAPublicKey(path string) (interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return jwt.ParseRSAPublicKeyFromPEM(data)
+}
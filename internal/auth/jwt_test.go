@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bishal05das/students-api/internal/config"
+	"github.com/bishal05das/students-api/internal/types"
+)
+
+func TestNewService_EmptyHS256Secret(t *testing.T) {
+	_, err := NewService(config.AuthConfig{SigningMethod: "HS256"})
+	if err == nil {
+		t.Fatal("expected an error for an empty HS256 secret, got nil")
+	}
+}
+
+func TestNewService_UnsupportedSigningMethod(t *testing.T) {
+	_, err := NewService(config.AuthConfig{SigningMethod: "ES256"})
+	if err == nil {
+		t.Fatal("expected an error for an unsupported signing method, got nil")
+	}
+}
+
+func TestIssueAndVerify_RoundTrip(t *testing.T) {
+	svc, err := NewService(config.AuthConfig{
+		SigningMethod: "HS256",
+		Secret:        "test-secret",
+		TokenTTL:      time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+
+	token, err := svc.IssueToken(42, types.RoleAdmin)
+	if err != nil {
+		t.Fatalf("IssueToken: %v", err)
+	}
+
+	claims, err := svc.Verify(token)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if claims.UserId != 42 || claims.Role != types.RoleAdmin {
+		t.Fatalf("claims = %+v, want UserId=42 Role=%q", claims, types.RoleAdmin)
+	}
+}
+
+func TestVerify_RejectsGarbage(t *testing.T) {
+	svc, err := NewService(config.AuthConfig{SigningMethod: "HS256", Secret: "test-secret", TokenTTL: time.Hour})
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+
+	if _, err := svc.Verify("not-a-token"); err == nil {
+		t.Fatal("expected an error for a garbage token, got nil")
+	}
+}
+
+func TestVerify_RejectsWrongSecret(t *testing.T) {
+	issuer, err := NewService(config.AuthConfig{SigningMethod: "HS256", Secret: "issuer-secret", TokenTTL: time.Hour})
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	token, err := issuer.IssueToken(1, types.RoleStudent)
+	if err != nil {
+		t.Fatalf("IssueToken: %v", err)
+	}
+
+	verifier, err := NewService(config.AuthConfig{SigningMethod: "HS256", Secret: "other-secret", TokenTTL: time.Hour})
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	if _, err := verifier.Verify(token); err == nil {
+		t.Fatal("expected verification to fail under a different secret, got nil")
+	}
+}
+
+func TestVerify_AcceptsRotatedSecret(t *testing.T) {
+	oldService, err := NewService(config.AuthConfig{SigningMethod: "HS256", Secret: "old-secret", TokenTTL: time.Hour})
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	token, err := oldService.IssueToken(1, types.RoleStudent)
+	if err != nil {
+		t.Fatalf("IssueToken: %v", err)
+	}
+
+	rotated, err := NewService(config.AuthConfig{
+		SigningMethod:   "HS256",
+		Secret:          "new-secret",
+		PreviousSecrets: []string{"old-secret"},
+		TokenTTL:        time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+
+	if _, err := rotated.Verify(token); err != nil {
+		t.Fatalf("expected a token signed under a previous secret to still verify, got: %v", err)
+	}
+}
+
+func TestVerify_RejectsExpiredToken(t *testing.T) {
+	svc, err := NewService(config.AuthConfig{SigningMethod: "HS256", Secret: "test-secret", TokenTTL: -time.Hour})
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+
+	token, err := svc.IssueToken(1, types.RoleStudent)
+	if err != nil {
+		t.Fatalf("IssueToken: %v", err)
+	}
+
+	if _, err := svc.Verify(token); err == nil {
+		t.Fatal("expected an already-expired token to fail verification, got nil")
+	}
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/bishal05das/students-api/internal/utils/response"
+)
+
+var ErrForbidden = errors.New("insufficient role for this action")
+
+type contextKey int
+
+const claimsKey contextKey = iota
+
+// ClaimsFromContext returns the claims RequireAuth attached to the
+// request context, if any.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey).(*Claims)
+	return claims, ok
+}
+
+// RequireAuth wraps next so it only runs for requests carrying a valid
+// "Authorization: Bearer <token>" header. When roles is non-empty, the
+// token's role claim must also be one of them -- e.g.
+// RequireAuth(svc)(student.GetList(storage)) lets any authenticated user
+// in, while RequireAuth(svc, types.RoleAdmin)(student.Delete(storage))
+// is admin-only.
+func RequireAuth(service *Service, roles ...string) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get("Authorization")
+			token, ok := strings.CutPrefix(header, "Bearer ")
+			if !ok || token == "" {
+				response.WriteJson(w, http.StatusUnauthorized, response.GeneralError(ErrInvalidToken))
+				return
+			}
+
+			claims, err := service.Verify(token)
+			if err != nil {
+				response.WriteJson(w, http.StatusUnauthorized, response.GeneralError(err))
+				return
+			}
+
+			if len(roles) > 0 && !slices.Contains(roles, claims.Role) {
+				response.WriteJson(w, http.StatusForbidden, response.GeneralError(ErrForbidden))
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), claimsKey, claims)
+			next(w, r.WithContext(ctx))
+		}
+	}
+}
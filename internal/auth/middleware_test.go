@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bishal05das/students-api/internal/config"
+	"github.com/bishal05das/students-api/internal/types"
+)
+
+func testService(t *testing.T) *Service {
+	t.Helper()
+	svc, err := NewService(config.AuthConfig{SigningMethod: "HS256", Secret: "test-secret", TokenTTL: time.Hour})
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	return svc
+}
+
+func okHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestRequireAuth_MissingHeader(t *testing.T) {
+	svc := testService(t)
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	RequireAuth(svc)(okHandler)(w, r)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestRequireAuth_InvalidToken(t *testing.T) {
+	svc := testService(t)
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "Bearer garbage")
+	RequireAuth(svc)(okHandler)(w, r)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestRequireAuth_NoRolesRequired_AnyAuthenticatedUser(t *testing.T) {
+	svc := testService(t)
+	token, err := svc.IssueToken(1, types.RoleStudent)
+	if err != nil {
+		t.Fatalf("IssueToken: %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "Bearer "+token)
+	RequireAuth(svc)(okHandler)(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+}
+
+func TestRequireAuth_WrongRole_Forbidden(t *testing.T) {
+	svc := testService(t)
+	token, err := svc.IssueToken(1, types.RoleStudent)
+	if err != nil {
+		t.Fatalf("IssueToken: %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "Bearer "+token)
+	RequireAuth(svc, types.RoleAdmin)(okHandler)(w, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+}
+
+func TestRequireAuth_RightRole_Passes(t *testing.T) {
+	svc := testService(t)
+	token, err := svc.IssueToken(1, types.RoleAdmin)
+	if err != nil {
+		t.Fatalf("IssueToken: %v", err)
+	}
+
+	var sawClaims bool
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := ClaimsFromContext(r.Context())
+		sawClaims = ok && claims.Role == types.RoleAdmin
+		w.WriteHeader(http.StatusOK)
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "Bearer "+token)
+	RequireAuth(svc, types.RoleAdmin)(handler)(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if !sawClaims {
+		t.Fatal("expected claims to be attached to the request context")
+	}
+}
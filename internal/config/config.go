@@ -0,0 +1,76 @@
+package config
+
+import (
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+type HTTPServer struct {
+	Addr string `yaml:"address" env-required:"true"`
+	// MetricsPath is where the Prometheus metrics handler is mounted.
+	MetricsPath string `yaml:"metrics_path" env:"METRICS_PATH" env-default:"/metrics"`
+}
+
+// StorageConfig selects the storage.Storage backend (storage.driver:
+// "sqlite", "postgres" or "bolt") and carries the connection details each
+// backend needs.
+type StorageConfig struct {
+	Driver      string `yaml:"driver" env:"STORAGE_DRIVER" env-default:"sqlite"`
+	StoragePath string `yaml:"storage_path"`
+	DSN         string `yaml:"dsn" env:"STORAGE_DSN"`
+}
+
+// AuthConfig configures JWT issuing and verification for internal/auth.
+// SigningMethod selects "HS256" (default, HMAC with Secret) or "RS256"
+// (RSA, loaded from the key-pair paths). PreviousSecrets lets an HS256
+// secret be rotated without invalidating tokens issued under the old one:
+// new tokens always sign with Secret, but verification also accepts any
+// key listed there.
+type AuthConfig struct {
+	SigningMethod     string        `yaml:"signing_method" env-default:"HS256"`
+	Secret            string        `yaml:"secret" env:"JWT_SECRET"`
+	PreviousSecrets   []string      `yaml:"previous_secrets"`
+	RSAPrivateKeyPath string        `yaml:"rsa_private_key_path"`
+	RSAPublicKeyPath  string        `yaml:"rsa_public_key_path"`
+	TokenTTL          time.Duration `yaml:"token_ttl" env-default:"24h"`
+}
+
+type Config struct {
+	Env        string        `yaml:"env" env:"ENV" env-required:"true"`
+	Storage    StorageConfig `yaml:"storage"`
+	Auth       AuthConfig    `yaml:"auth"`
+	HTTPServer `yaml:"http_server"`
+}
+
+func MustLoad() *Config {
+	var configPath string
+
+	configPath = os.Getenv("CONFIG_PATH")
+
+	if configPath == "" {
+		flags := flag.String("config", "", "path to the configuration file")
+		flag.Parse()
+
+		configPath = *flags
+		if configPath == "" {
+			log.Fatal("config path is not set")
+		}
+	}
+
+	if _, err := os.Stat(configPath); os.IsNotExist(err) {
+		log.Fatalf("config file does not exist: %s", configPath)
+	}
+
+	var cfg Config
+
+	err := cleanenv.ReadConfig(configPath, &cfg)
+	if err != nil {
+		log.Fatalf("cannot read config file: %s", err.Error())
+	}
+
+	return &cfg
+}
@@ -0,0 +1,41 @@
+// Package health provides the liveness and readiness HTTP handlers used by
+// container/orchestrator health checks.
+package health
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/bishal05das/students-api/internal/storage"
+	"github.com/bishal05das/students-api/internal/utils/response"
+)
+
+// pingTimeout bounds how long Ready waits on storage.Ping before reporting
+// not-ready, so a stuck dependency can't hang the probe indefinitely.
+const pingTimeout = time.Second
+
+// Live handles GET /healthz: it always returns 200 once the process is up
+// and serving, with no dependency checks.
+func Live() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		response.WriteJson(w, http.StatusOK, map[string]string{"status": "ok"})
+	}
+}
+
+// Ready handles GET /readyz: it pings store with a bounded timeout, so an
+// orchestrator can tell "process is up" (Live) apart from "dependencies are
+// reachable" (Ready).
+func Ready(store storage.Storage) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), pingTimeout)
+		defer cancel()
+
+		if err := store.Ping(ctx); err != nil {
+			response.WriteJson(w, http.StatusServiceUnavailable, response.GeneralError(err))
+			return
+		}
+
+		response.WriteJson(w, http.StatusOK, map[string]string{"status": "ok"})
+	}
+}
@@ -9,15 +9,19 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/bishal05das/students-api/internal/metrics"
+	"github.com/bishal05das/students-api/internal/middleware/logging"
 	"github.com/bishal05das/students-api/internal/storage"
 	"github.com/bishal05das/students-api/internal/types"
+	"github.com/bishal05das/students-api/internal/utils/query"
 	"github.com/bishal05das/students-api/internal/utils/response"
 	"github.com/go-playground/validator/v10"
 )
 
-func New(storage storage.Storage) http.HandlerFunc {
+func New(store storage.Storage, reg *metrics.Registry) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		slog.Info("creating a student")
+		log := logging.FromContext(r.Context())
+		log.Info("creating a student")
 		var student types.Student
 
 		err := json.NewDecoder(r.Body).Decode(&student)
@@ -39,51 +43,193 @@ func New(storage storage.Storage) http.HandlerFunc {
 			return
 		}
 
-		lastId,err := storage.CreateStudent(student.Name, student.Email, student.Age)
-        
-		slog.Info("user created successfully", slog.String("userId",fmt.Sprint(lastId)))
+		lastId, err := store.CreateStudent(student.Name, student.Email, student.Age)
+
+		log.Info("user created successfully", slog.String("userId", fmt.Sprint(lastId)))
 
 		if err != nil {
 			response.WriteJson(w, http.StatusInternalServerError, err)
 			return
 		}
 
+		reg.IncStudentsCreated()
+
 		// w.Write([]byte("welcome to the students api"))
 		response.WriteJson(w, http.StatusCreated, map[string]int64{"id": lastId})
 	}
 }
 
-
-func GetById(storage storage.Storage) http.HandlerFunc {
+func GetById(store storage.Storage) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		log := logging.FromContext(r.Context())
 		id := r.PathValue("id")
-		slog.Info("getting student by id", slog.String("id", id))
+		log.Info("getting student by id", slog.String("id", id))
 
 		intId, err := strconv.ParseInt(id, 10, 64)
 		if err != nil {
 			response.WriteJson(w, http.StatusBadRequest, response.GeneralError(err))
 			return
 		}
-		student, err := storage.GetStudentById(intId)
+		student, err := store.GetStudentById(intId)
+		if errors.Is(err, storage.ErrStudentNotFound) {
+			response.WriteJson(w, http.StatusNotFound, response.GeneralError(err))
+			return
+		}
 		if err != nil {
-			slog.Error("error getting user",slog.String("id",id))
+			log.Error("error getting user", slog.String("id", id))
 			response.WriteJson(w, http.StatusInternalServerError, response.GeneralError(err))
+			return
+		}
+		response.WriteJson(w, http.StatusOK, student)
+	}
+}
+
+func GetList(store storage.Storage) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := logging.FromContext(r.Context())
 
-	    }
-	response.WriteJson(w, http.StatusOK, student)	
+		log.Info("getting list of students")
+
+		opts, err := query.Parse(r)
+		if err != nil {
+			response.WriteJson(w, http.StatusBadRequest, response.GeneralError(err))
+			return
+		}
+
+		students, total, err := store.GetStudents(opts)
+		if err != nil {
+			response.WriteJson(w, http.StatusInternalServerError, response.GeneralError(err))
+			return
+		}
+
+		response.WriteJson(w, http.StatusOK, response.Paginated{
+			Data:  students,
+			Page:  opts.Page,
+			Limit: opts.Limit,
+			Total: total,
+		})
+	}
 }
+
+// Update handles PATCH /api/students/{id}: a partial update where only the
+// fields present in the request body are applied.
+func Update(store storage.Storage) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := logging.FromContext(r.Context())
+		id := r.PathValue("id")
+		log.Info("updating student", slog.String("id", id))
+
+		intId, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			response.WriteJson(w, http.StatusBadRequest, response.GeneralError(err))
+			return
+		}
+
+		var patch types.StudentPatch
+		err = json.NewDecoder(r.Body).Decode(&patch)
+		if errors.Is(err, io.EOF) {
+			response.WriteJson(w, http.StatusBadRequest, response.GeneralError(fmt.Errorf("empty body")))
+			return
+		}
+		if err != nil {
+			response.WriteJson(w, http.StatusBadRequest, response.GeneralError(err))
+			return
+		}
+
+		if err := validator.New().Struct(patch); err != nil {
+			validateErrs := err.(validator.ValidationErrors)
+			response.WriteJson(w, http.StatusBadRequest, response.ValidationError(validateErrs))
+			return
+		}
+
+		student, err := store.UpdateStudent(intId, patch)
+		if errors.Is(err, storage.ErrStudentNotFound) {
+			response.WriteJson(w, http.StatusNotFound, response.GeneralError(err))
+			return
+		}
+		if err != nil {
+			response.WriteJson(w, http.StatusInternalServerError, response.GeneralError(err))
+			return
+		}
+
+		response.WriteJson(w, http.StatusOK, student)
+	}
 }
 
+// Replace handles PUT /api/students/{id}: a full replacement of the
+// student's fields, so every field is required.
+func Replace(store storage.Storage) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := logging.FromContext(r.Context())
+		id := r.PathValue("id")
+		log.Info("replacing student", slog.String("id", id))
+
+		intId, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			response.WriteJson(w, http.StatusBadRequest, response.GeneralError(err))
+			return
+		}
+
+		var student types.Student
+		err = json.NewDecoder(r.Body).Decode(&student)
+		if errors.Is(err, io.EOF) {
+			response.WriteJson(w, http.StatusBadRequest, response.GeneralError(fmt.Errorf("empty body")))
+			return
+		}
+		if err != nil {
+			response.WriteJson(w, http.StatusBadRequest, response.GeneralError(err))
+			return
+		}
+
+		if err := validator.New().Struct(student); err != nil {
+			validateErrs := err.(validator.ValidationErrors)
+			response.WriteJson(w, http.StatusBadRequest, response.ValidationError(validateErrs))
+			return
+		}
+
+		patch := types.StudentPatch{
+			Name:  &student.Name,
+			Email: &student.Email,
+			Age:   &student.Age,
+		}
 
-func GetList(storage storage.Storage) http.HandlerFunc {
+		updated, err := store.UpdateStudent(intId, patch)
+		if errors.Is(err, storage.ErrStudentNotFound) {
+			response.WriteJson(w, http.StatusNotFound, response.GeneralError(err))
+			return
+		}
+		if err != nil {
+			response.WriteJson(w, http.StatusInternalServerError, response.GeneralError(err))
+			return
+		}
+
+		response.WriteJson(w, http.StatusOK, updated)
+	}
+}
+
+// Delete handles DELETE /api/students/{id}.
+func Delete(store storage.Storage) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-       
-		slog.Info("getting list of students")
-		students, err := storage.GetStudents()
+		log := logging.FromContext(r.Context())
+		id := r.PathValue("id")
+		log.Info("deleting student", slog.String("id", id))
+
+		intId, err := strconv.ParseInt(id, 10, 64)
 		if err != nil {
-			response.WriteJson(w, http.StatusInternalServerError,err)
+			response.WriteJson(w, http.StatusBadRequest, response.GeneralError(err))
 			return
-	    }
-		response.WriteJson(w, http.StatusOK, students)
+		}
+
+		err = store.DeleteStudent(intId)
+		if errors.Is(err, storage.ErrStudentNotFound) {
+			response.WriteJson(w, http.StatusNotFound, response.GeneralError(err))
+			return
+		}
+		if err != nil {
+			response.WriteJson(w, http.StatusInternalServerError, response.GeneralError(err))
+			return
+		}
+
+		response.WriteJson(w, http.StatusOK, map[string]int64{"id": intId})
+	}
 }
-}
\ No newline at end of file
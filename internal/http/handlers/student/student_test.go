@@ -0,0 +1,238 @@
+package student
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/bishal05das/students-api/internal/metrics"
+	"github.com/bishal05das/students-api/internal/storage"
+	"github.com/bishal05das/students-api/internal/storage/memory"
+	"github.com/bishal05das/students-api/internal/types"
+)
+
+// doRequest builds a request for handler, optionally JSON-encoding body and
+// setting the "id" path value the way the real mux would before the
+// handler runs.
+func doRequest(t *testing.T, handler http.HandlerFunc, method, id string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var r *http.Request
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("marshal request body: %v", err)
+		}
+		r = httptest.NewRequest(method, "/api/students", bytes.NewReader(b))
+	} else {
+		r = httptest.NewRequest(method, "/api/students", nil)
+	}
+	if id != "" {
+		r.SetPathValue("id", id)
+	}
+
+	w := httptest.NewRecorder()
+	handler(w, r)
+	return w
+}
+
+func decodeResponse(t *testing.T, w *httptest.ResponseRecorder, v any) {
+	t.Helper()
+	if err := json.NewDecoder(w.Body).Decode(v); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+}
+
+func TestNew_Success(t *testing.T) {
+	store := memory.New()
+	reg := metrics.NewRegistry()
+
+	w := doRequest(t, New(store, reg), http.MethodPost, "", types.Student{Name: "Ada", Email: "ada@example.com", Age: 30})
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d (body: %s)", w.Code, http.StatusCreated, w.Body.String())
+	}
+
+	var got map[string]int64
+	decodeResponse(t, w, &got)
+	if got["id"] == 0 {
+		t.Fatalf("expected a non-zero id, got %v", got)
+	}
+}
+
+func TestNew_EmptyBody(t *testing.T) {
+	store := memory.New()
+	reg := metrics.NewRegistry()
+
+	w := doRequest(t, New(store, reg), http.MethodPost, "", nil)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestNew_ValidationError(t *testing.T) {
+	store := memory.New()
+	reg := metrics.NewRegistry()
+
+	// Name is required and missing.
+	w := doRequest(t, New(store, reg), http.MethodPost, "", types.Student{Email: "ada@example.com", Age: 30})
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestGetById_NotFound(t *testing.T) {
+	store := memory.New()
+
+	w := doRequest(t, GetById(store), http.MethodGet, "999", nil)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestGetById_Success(t *testing.T) {
+	store := memory.New()
+	id, err := store.CreateStudent("Ada", "ada@example.com", 30)
+	if err != nil {
+		t.Fatalf("CreateStudent: %v", err)
+	}
+
+	w := doRequest(t, GetById(store), http.MethodGet, strconv.FormatInt(id, 10), nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var got types.Student
+	decodeResponse(t, w, &got)
+	if got.Name != "Ada" {
+		t.Fatalf("Name = %q, want %q", got.Name, "Ada")
+	}
+}
+
+func TestUpdate_NotFound(t *testing.T) {
+	store := memory.New()
+	name := "Ada"
+
+	w := doRequest(t, Update(store), http.MethodPatch, "999", types.StudentPatch{Name: &name})
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestUpdate_ValidationError(t *testing.T) {
+	store := memory.New()
+	id, err := store.CreateStudent("Ada", "ada@example.com", 30)
+	if err != nil {
+		t.Fatalf("CreateStudent: %v", err)
+	}
+
+	bad := "not-an-email"
+	w := doRequest(t, Update(store), http.MethodPatch, strconv.FormatInt(id, 10), types.StudentPatch{Email: &bad})
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestUpdate_Success(t *testing.T) {
+	store := memory.New()
+	id, err := store.CreateStudent("Ada", "ada@example.com", 30)
+	if err != nil {
+		t.Fatalf("CreateStudent: %v", err)
+	}
+
+	name := "Grace"
+	w := doRequest(t, Update(store), http.MethodPatch, strconv.FormatInt(id, 10), types.StudentPatch{Name: &name})
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var got types.Student
+	decodeResponse(t, w, &got)
+	if got.Name != "Grace" || got.Email != "ada@example.com" {
+		t.Fatalf("got = %+v, want Name updated and Email unchanged", got)
+	}
+}
+
+func TestReplace_NotFound(t *testing.T) {
+	store := memory.New()
+
+	w := doRequest(t, Replace(store), http.MethodPut, "999", types.Student{Name: "Grace", Email: "grace@example.com", Age: 40})
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestReplace_ValidationError(t *testing.T) {
+	store := memory.New()
+	id, err := store.CreateStudent("Ada", "ada@example.com", 30)
+	if err != nil {
+		t.Fatalf("CreateStudent: %v", err)
+	}
+
+	// Age is required and missing.
+	w := doRequest(t, Replace(store), http.MethodPut, strconv.FormatInt(id, 10), types.Student{Name: "Grace", Email: "grace@example.com"})
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestReplace_Success(t *testing.T) {
+	store := memory.New()
+	id, err := store.CreateStudent("Ada", "ada@example.com", 30)
+	if err != nil {
+		t.Fatalf("CreateStudent: %v", err)
+	}
+
+	w := doRequest(t, Replace(store), http.MethodPut, strconv.FormatInt(id, 10), types.Student{Name: "Grace", Email: "grace@example.com", Age: 40})
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var got types.Student
+	decodeResponse(t, w, &got)
+	if got.Name != "Grace" || got.Email != "grace@example.com" || got.Age != 40 {
+		t.Fatalf("got = %+v, want full replacement", got)
+	}
+}
+
+func TestDelete_NotFound(t *testing.T) {
+	store := memory.New()
+
+	w := doRequest(t, Delete(store), http.MethodDelete, "999", nil)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestDelete_Success(t *testing.T) {
+	store := memory.New()
+	id, err := store.CreateStudent("Ada", "ada@example.com", 30)
+	if err != nil {
+		t.Fatalf("CreateStudent: %v", err)
+	}
+
+	w := doRequest(t, Delete(store), http.MethodDelete, strconv.FormatInt(id, 10), nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	if _, err := store.GetStudentById(id); !errors.Is(err, storage.ErrStudentNotFound) {
+		t.Fatalf("expected student to be deleted, GetStudentById err = %v", err)
+	}
+}
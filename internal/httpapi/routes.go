@@ -0,0 +1,73 @@
+// Package httpapi wires the HTTP route table shared between the server's
+// main entrypoint and the openapi-validate tool, so both build the exact
+// same routes instead of keeping two hand-maintained copies in sync.
+package httpapi
+
+import (
+	"net/http"
+
+	"github.com/bishal05das/students-api/internal/auth"
+	authhandler "github.com/bishal05das/students-api/internal/http/handlers/auth"
+	"github.com/bishal05das/students-api/internal/http/handlers/health"
+	"github.com/bishal05das/students-api/internal/http/handlers/student"
+	"github.com/bishal05das/students-api/internal/metrics"
+	"github.com/bishal05das/students-api/internal/openapi"
+	"github.com/bishal05das/students-api/internal/storage"
+	"github.com/bishal05das/students-api/internal/types"
+	"github.com/bishal05das/students-api/internal/utils/response"
+)
+
+// Routes builds the router for store/users/authService and the OpenAPI
+// registry describing it, mounts GET /openapi.json, GET /docs, GET
+// /healthz and GET /readyz alongside the API routes, and serves reg's
+// metrics at metricsPath.
+func Routes(store storage.Storage, users storage.UserStorage, authService *auth.Service, reg *metrics.Registry, metricsPath string) (*http.ServeMux, *openapi.Registry) {
+	requireAuth := auth.RequireAuth(authService)
+	requireAdmin := auth.RequireAuth(authService, types.RoleAdmin)
+
+	router := http.NewServeMux()
+	routes := openapi.NewRegistry()
+
+	routes.Handle(router, openapi.Route{
+		Method: "POST", Path: "/api/auth/register", Tags: []string{"auth"},
+		Summary: "Register a new user", RequestBody: types.RegisterRequest{},
+	}, authhandler.Register(users, authService))
+	routes.Handle(router, openapi.Route{
+		Method: "POST", Path: "/api/auth/login", Tags: []string{"auth"},
+		Summary: "Log in and receive a JWT", RequestBody: types.LoginRequest{},
+	}, authhandler.Login(users, authService))
+
+	routes.Handle(router, openapi.Route{
+		Method: "POST", Path: "/api/students", Tags: []string{"students"},
+		Summary: "Create a student", RequestBody: types.Student{}, Response: map[string]int64{"id": 0},
+	}, requireAdmin(student.New(store, reg)))
+	routes.Handle(router, openapi.Route{
+		Method: "GET", Path: "/api/students/{id}", Tags: []string{"students"},
+		Summary: "Get a student by id", Response: types.Student{},
+	}, requireAuth(student.GetById(store)))
+	routes.Handle(router, openapi.Route{
+		Method: "GET", Path: "/api/students", Tags: []string{"students"},
+		Summary: "List students with pagination, filtering and sorting", Response: response.Paginated{},
+	}, requireAuth(student.GetList(store)))
+	routes.Handle(router, openapi.Route{
+		Method: "PATCH", Path: "/api/students/{id}", Tags: []string{"students"},
+		Summary: "Partially update a student", RequestBody: types.StudentPatch{}, Response: types.Student{},
+	}, requireAdmin(student.Update(store)))
+	routes.Handle(router, openapi.Route{
+		Method: "PUT", Path: "/api/students/{id}", Tags: []string{"students"},
+		Summary: "Replace a student", RequestBody: types.Student{}, Response: types.Student{},
+	}, requireAdmin(student.Replace(store)))
+	routes.Handle(router, openapi.Route{
+		Method: "DELETE", Path: "/api/students/{id}", Tags: []string{"students"},
+		Summary: "Delete a student", Response: map[string]int64{"id": 0},
+	}, requireAdmin(student.Delete(store)))
+
+	router.HandleFunc("GET /openapi.json", openapi.Handler(routes, "students-api", "1.0.0"))
+	router.HandleFunc("GET /docs", openapi.DocsHandler("/openapi.json"))
+
+	router.HandleFunc("GET /healthz", health.Live())
+	router.HandleFunc("GET /readyz", health.Ready(store))
+	router.HandleFunc("GET "+metricsPath, metrics.Handler(reg))
+
+	return router, routes
+}
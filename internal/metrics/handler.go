@@ -0,0 +1,12 @@
+package metrics
+
+import "net/http"
+
+// Handler serves reg's metrics at whatever path main mounts it on (see
+// config.HTTPServer.MetricsPath), in the Prometheus text exposition format.
+func Handler(reg *Registry) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		w.Write([]byte(reg.Render()))
+	}
+}
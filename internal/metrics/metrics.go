@@ -0,0 +1,188 @@
+// Package metrics collects the counters and histograms GET /metrics
+// exposes, in the Prometheus text exposition format, without pulling in an
+// external client library.
+package metrics
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultBuckets mirrors the client_golang default buckets, which cover a
+// typical HTTP handler's latency range in seconds.
+var defaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+type requestKey struct {
+	method string
+	route  string
+	status int
+}
+
+type durationKey struct {
+	method string
+	route  string
+}
+
+// Registry holds the process's metrics. The zero value is not usable; use
+// NewRegistry.
+type Registry struct {
+	mu              sync.Mutex
+	requestTotals   map[requestKey]*counter
+	requestDuration map[durationKey]*histogram
+	studentsCreated counter
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		requestTotals:   make(map[requestKey]*counter),
+		requestDuration: make(map[durationKey]*histogram),
+	}
+}
+
+// ObserveRequest records one completed request for http_requests_total and
+// http_request_duration_seconds.
+func (reg *Registry) ObserveRequest(method, route string, status int, duration time.Duration) {
+	rk := requestKey{method: method, route: route, status: status}
+	dk := durationKey{method: method, route: route}
+
+	reg.mu.Lock()
+	c, ok := reg.requestTotals[rk]
+	if !ok {
+		c = &counter{}
+		reg.requestTotals[rk] = c
+	}
+	h, ok := reg.requestDuration[dk]
+	if !ok {
+		h = newHistogram(defaultBuckets)
+		reg.requestDuration[dk] = h
+	}
+	reg.mu.Unlock()
+
+	c.inc()
+	h.observe(duration.Seconds())
+}
+
+// IncStudentsCreated increments students_created_total by one.
+func (reg *Registry) IncStudentsCreated() {
+	reg.studentsCreated.inc()
+}
+
+// Render writes reg's metrics in the Prometheus text exposition format.
+func (reg *Registry) Render() string {
+	reg.mu.Lock()
+	reqKeys := make([]requestKey, 0, len(reg.requestTotals))
+	for k := range reg.requestTotals {
+		reqKeys = append(reqKeys, k)
+	}
+	durKeys := make([]durationKey, 0, len(reg.requestDuration))
+	for k := range reg.requestDuration {
+		durKeys = append(durKeys, k)
+	}
+	reg.mu.Unlock()
+
+	sort.Slice(reqKeys, func(i, j int) bool {
+		a, b := reqKeys[i], reqKeys[j]
+		if a.method != b.method {
+			return a.method < b.method
+		}
+		if a.route != b.route {
+			return a.route < b.route
+		}
+		return a.status < b.status
+	})
+	sort.Slice(durKeys, func(i, j int) bool {
+		a, b := durKeys[i], durKeys[j]
+		if a.method != b.method {
+			return a.method < b.method
+		}
+		return a.route < b.route
+	})
+
+	var b strings.Builder
+
+	b.WriteString("# HELP http_requests_total Total number of HTTP requests.\n")
+	b.WriteString("# TYPE http_requests_total counter\n")
+	for _, k := range reqKeys {
+		fmt.Fprintf(&b, "http_requests_total{method=%q,route=%q,status=%q} %s\n",
+			k.method, k.route, strconv.Itoa(k.status), formatValue(reg.requestTotals[k].get()))
+	}
+
+	b.WriteString("# HELP http_request_duration_seconds HTTP request latency in seconds.\n")
+	b.WriteString("# TYPE http_request_duration_seconds histogram\n")
+	for _, k := range durKeys {
+		reg.requestDuration[k].render(&b, k.method, k.route)
+	}
+
+	b.WriteString("# HELP students_created_total Total number of students created.\n")
+	b.WriteString("# TYPE students_created_total counter\n")
+	fmt.Fprintf(&b, "students_created_total %s\n", formatValue(reg.studentsCreated.get()))
+
+	return b.String()
+}
+
+func formatValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+type counter struct {
+	mu    sync.Mutex
+	value float64
+}
+
+func (c *counter) inc() {
+	c.mu.Lock()
+	c.value++
+	c.mu.Unlock()
+}
+
+func (c *counter) get() float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.value
+}
+
+// histogram is a cumulative Prometheus-style histogram: counts[i] holds the
+// number of observations <= buckets[i], same as the bucket semantics the
+// text format expects.
+type histogram struct {
+	mu      sync.Mutex
+	buckets []float64
+	counts  []uint64
+	sum     float64
+	count   uint64
+}
+
+func newHistogram(buckets []float64) *histogram {
+	return &histogram{buckets: buckets, counts: make([]uint64, len(buckets))}
+}
+
+func (h *histogram) observe(seconds float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.sum += seconds
+	h.count++
+	for i, bound := range h.buckets {
+		if seconds <= bound {
+			h.counts[i]++
+		}
+	}
+}
+
+func (h *histogram) render(b *strings.Builder, method, route string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for i, bound := range h.buckets {
+		fmt.Fprintf(b, "http_request_duration_seconds_bucket{method=%q,route=%q,le=%q} %d\n",
+			method, route, formatValue(bound), h.counts[i])
+	}
+	fmt.Fprintf(b, "http_request_duration_seconds_bucket{method=%q,route=%q,le=\"+Inf\"} %d\n", method, route, h.count)
+	fmt.Fprintf(b, "http_request_duration_seconds_sum{method=%q,route=%q} %s\n", method, route, formatValue(h.sum))
+	fmt.Fprintf(b, "http_request_duration_seconds_count{method=%q,route=%q} %d\n", method, route, h.count)
+}
@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Middleware times every request and records it against reg as
+// http_requests_total/http_request_duration_seconds. It needs mux itself
+// (not just the wrapped handler) to recover the matched route pattern via
+// ServeMux.Handler, so the route label is the registered pattern (e.g.
+// "/api/students/{id}") rather than every distinct id that hits it.
+func Middleware(reg *Registry, mux *http.ServeMux) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, pattern := mux.Handler(r)
+
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+
+			start := time.Now()
+			next.ServeHTTP(sw, r)
+
+			reg.ObserveRequest(r.Method, routeLabel(pattern), sw.status, time.Since(start))
+		})
+	}
+}
+
+// routeLabel strips the leading "METHOD " that Go 1.22+ mux patterns carry,
+// since the method is already its own label.
+func routeLabel(pattern string) string {
+	if pattern == "" {
+		return "unmatched"
+	}
+	if _, path, ok := strings.Cut(pattern, " "); ok {
+		return path
+	}
+	return pattern
+}
+
+// statusWriter wraps http.ResponseWriter to capture the status code for the
+// request metric, the same way logging.statusWriter does for the log line.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,99 @@
+// Package logging provides a request-scoped structured logger: a
+// middleware that tags every request with a request ID and logs one
+// summary line per request, and a FromContext accessor so handlers can
+// log through a logger that already carries that request ID.
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ctxKey int
+
+const (
+	requestIDKey ctxKey = iota
+	loggerKey
+)
+
+// NewHandler builds the slog.Handler for the configured environment: a
+// human-readable text handler for "local", JSON everywhere else.
+func NewHandler(env string) slog.Handler {
+	opts := &slog.HandlerOptions{Level: slog.LevelDebug}
+
+	if env == "local" {
+		return slog.NewTextHandler(os.Stdout, opts)
+	}
+
+	return slog.NewJSONHandler(os.Stdout, opts)
+}
+
+// Middleware assigns each request a UUID request ID, logs one structured
+// line per request (method, path, remote addr, status, duration_ms,
+// request_id) once it completes, and makes a logger bound to that request
+// ID available to handlers via FromContext.
+func Middleware(logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := uuid.NewString()
+			reqLogger := logger.With(slog.String("request_id", requestID))
+
+			ctx := context.WithValue(r.Context(), requestIDKey, requestID)
+			ctx = context.WithValue(ctx, loggerKey, reqLogger)
+
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+
+			start := time.Now()
+			next.ServeHTTP(sw, r.WithContext(ctx))
+			duration := time.Since(start)
+
+			reqLogger.Info("handled request",
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Path),
+				slog.String("remote_addr", r.RemoteAddr),
+				slog.Int("status", sw.status),
+				slog.Int("bytes", sw.bytes),
+				slog.Int64("duration_ms", duration.Milliseconds()),
+			)
+		})
+	}
+}
+
+// FromContext returns the request-scoped logger Middleware attached to
+// ctx, or slog.Default() if none is present (e.g. outside a request).
+func FromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+// RequestIDFromContext returns the request ID Middleware generated, if any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey).(string)
+	return id, ok
+}
+
+// statusWriter wraps http.ResponseWriter to capture the status code and
+// byte count written, for the request log line.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+	return n, err
+}
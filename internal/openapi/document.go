@@ -0,0 +1,119 @@
+package openapi
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Route describes one HTTP endpoint for both the live router and the
+// generated OpenAPI document. RequestBody and Response are zero values of
+// the types the handler decodes/encodes (e.g. types.Student{}); either may
+// be nil.
+type Route struct {
+	Method      string
+	Path        string
+	Summary     string
+	Tags        []string
+	RequestBody any
+	Response    any
+}
+
+// Registry collects routes as they're mounted on the router so the
+// generated spec can never drift from what's actually served.
+type Registry struct {
+	routes []Route
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Handle registers route's handler on mux and records route for Build.
+func (reg *Registry) Handle(mux *http.ServeMux, route Route, handler http.HandlerFunc) {
+	reg.routes = append(reg.routes, route)
+	mux.HandleFunc(route.Method+" "+route.Path, handler)
+}
+
+// Document is the subset of the OpenAPI 3.0 Document Object this package
+// emits -- enough for Swagger UI and codegen tools to render the API.
+type Document struct {
+	OpenAPI string                           `json:"openapi"`
+	Info    Info                             `json:"info"`
+	Paths   map[string]map[string]*Operation `json:"paths"`
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type Operation struct {
+	Summary     string               `json:"summary,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+type RequestBody struct {
+	Content map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema,omitempty"`
+}
+
+// Build reflects reg's routes into a Document. It's called fresh on every
+// request to /openapi.json (see Handler) rather than cached, since that's
+// simpler than invalidating a cache and the reflection cost is tiny next to
+// an HTTP round trip.
+func (reg *Registry) Build(title, version string) Document {
+	doc := Document{
+		OpenAPI: "3.0.3",
+		Info:    Info{Title: title, Version: version},
+		Paths:   map[string]map[string]*Operation{},
+	}
+
+	for _, route := range reg.routes {
+		// Go's http.ServeMux already spells path params as "{name}", the
+		// same syntax OpenAPI uses, so route.Path needs no translation.
+		path := route.Path
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = map[string]*Operation{}
+		}
+
+		op := &Operation{
+			Summary: route.Summary,
+			Tags:    route.Tags,
+			Responses: map[string]*Response{
+				"default": {Description: "Unexpected error"},
+			},
+		}
+
+		if route.RequestBody != nil {
+			op.RequestBody = &RequestBody{
+				Content: map[string]MediaType{
+					"application/json": {Schema: schemaFor(route.RequestBody)},
+				},
+			}
+		}
+
+		if route.Response != nil {
+			op.Responses["200"] = &Response{
+				Description: "OK",
+				Content: map[string]MediaType{
+					"application/json": {Schema: schemaFor(route.Response)},
+				},
+			}
+		}
+
+		doc.Paths[path][strings.ToLower(route.Method)] = op
+	}
+
+	return doc
+}
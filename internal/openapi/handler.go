@@ -0,0 +1,37 @@
+package openapi
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"net/http"
+)
+
+//go:embed static/docs.html
+var staticFS embed.FS
+
+// Handler serves the reflected OpenAPI document as JSON, built fresh from
+// reg's current routes on every request.
+func Handler(reg *Registry, title, version string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(reg.Build(title, version))
+	}
+}
+
+// DocsHandler serves a self-contained, go:embed'd API explorer with a live
+// "try it out" per route -- not the official swagger-ui-dist bundle, since
+// vendoring that asset isn't possible without npm/network access. It reads
+// specPath, so it never drifts from the live route table.
+func DocsHandler(specPath string) http.HandlerFunc {
+	page, err := staticFS.ReadFile("static/docs.html")
+	if err != nil {
+		panic(err)
+	}
+	page = bytes.ReplaceAll(page, []byte("{{.SpecPath}}"), []byte(specPath))
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(page)
+	}
+}
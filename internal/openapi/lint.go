@@ -0,0 +1,39 @@
+package openapi
+
+import "fmt"
+
+// Lint runs a handful of structural sanity checks against doc -- enough to
+// catch an empty openapi version, title or version, an operation with an
+// empty responses map, or a Build that silently produced an empty document
+// -- without pulling in an external OpenAPI validator. Build gives every
+// operation a "default" response, so a route registered with a nil Response
+// still passes. It returns one message per problem found.
+func Lint(doc Document) []string {
+	var errs []string
+
+	if doc.OpenAPI == "" {
+		errs = append(errs, "openapi version is empty")
+	}
+	if doc.Info.Title == "" {
+		errs = append(errs, "info.title is empty")
+	}
+	if doc.Info.Version == "" {
+		errs = append(errs, "info.version is empty")
+	}
+	if len(doc.Paths) == 0 {
+		errs = append(errs, "document has no paths")
+	}
+
+	for path, operations := range doc.Paths {
+		if len(operations) == 0 {
+			errs = append(errs, fmt.Sprintf("%s: has no operations", path))
+		}
+		for method, op := range operations {
+			if len(op.Responses) == 0 {
+				errs = append(errs, fmt.Sprintf("%s %s: has no responses", method, path))
+			}
+		}
+	}
+
+	return errs
+}
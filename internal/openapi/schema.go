@@ -0,0 +1,125 @@
+// Package openapi builds an OpenAPI 3.0 document and a Swagger UI from the
+// routes registered on the live router, so the two can't drift apart: add a
+// route to the Registry and it shows up in both GET /openapi.json and
+// GET /docs without hand-written spec maintenance.
+package openapi
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Schema is the subset of the OpenAPI 3.0 Schema Object this package emits.
+type Schema struct {
+	Type       string             `json:"type,omitempty"`
+	Format     string             `json:"format,omitempty"`
+	Items      *Schema            `json:"items,omitempty"`
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	Required   []string           `json:"required,omitempty"`
+	Enum       []string           `json:"enum,omitempty"`
+	Minimum    *float64           `json:"minimum,omitempty"`
+}
+
+// schemaFor reflects a Go value into a Schema, deriving required/enum/
+// minimum constraints from the same `validate` tags the handlers already
+// check with validator.New().Struct.
+func schemaFor(v any) *Schema {
+	if v == nil {
+		return nil
+	}
+	return schemaForType(reflect.TypeOf(v))
+}
+
+func schemaForType(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: schemaForType(t.Elem())}
+	case reflect.Struct:
+		return schemaForStruct(t)
+	case reflect.Map:
+		return &Schema{Type: "object"}
+	default:
+		return &Schema{Type: "object"}
+	}
+}
+
+func schemaForStruct(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+
+		prop := schemaForType(field.Type)
+		applyValidateTag(prop, field.Tag.Get("validate"))
+		schema.Properties[name] = prop
+
+		if isRequired(field.Tag.Get("validate")) {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+
+	return schema
+}
+
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	return name, true
+}
+
+func isRequired(validateTag string) bool {
+	for _, rule := range strings.Split(validateTag, ",") {
+		if rule == "required" {
+			return true
+		}
+	}
+	return false
+}
+
+// applyValidateTag folds the rules the repo actually uses (required, email,
+// gt, min, oneof) into the property's schema. Anything else is ignored --
+// it's still documented via Required/Type, just without the extra
+// constraint.
+func applyValidateTag(prop *Schema, validateTag string) {
+	for _, rule := range strings.Split(validateTag, ",") {
+		name, value, _ := strings.Cut(rule, "=")
+		switch name {
+		case "email":
+			prop.Format = "email"
+		case "gt", "min":
+			if f, err := strconv.ParseFloat(value, 64); err == nil {
+				prop.Minimum = &f
+			}
+		case "oneof":
+			prop.Enum = strings.Split(value, " ")
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package bolt
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/bishal05das/students-api/internal/config"
+	"github.com/bishal05das/students-api/internal/storage"
+	"github.com/bishal05das/students-api/internal/types"
+	"github.com/bishal05das/students-api/internal/utils/query"
+	bolt "go.etcd.io/bbolt"
+)
+
+func init() {
+	storage.Register("bolt", func(cfg *config.Config) (storage.Storage, error) {
+		return New(cfg)
+	})
+}
+
+var studentsBucket = []byte("students")
+
+type Bolt struct {
+	Db *bolt.DB
+}
+
+func New(cfg *config.Config) (*Bolt, error) {
+	db, err := bolt.Open(cfg.Storage.StoragePath, 0o600, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(studentsBucket)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Bolt{
+		Db: db,
+	}, nil
+}
+
+func idKey(id int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(id))
+	return key
+}
+
+func (b *Bolt) CreateStudent(name string, email string, age int) (int64, error) {
+	var lastId int64
+
+	err := b.Db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(studentsBucket)
+
+		id, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		student := types.Student{Id: int64(id), Name: name, Email: email, Age: age}
+
+		data, err := json.Marshal(student)
+		if err != nil {
+			return err
+		}
+
+		lastId = student.Id
+
+		return bucket.Put(idKey(lastId), data)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return lastId, nil
+}
+
+func (b *Bolt) GetStudentById(id int64) (types.Student, error) {
+	var student types.Student
+
+	err := b.Db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(studentsBucket).Get(idKey(id))
+		if data == nil {
+			return storage.ErrStudentNotFound
+		}
+		return json.Unmarshal(data, &student)
+	})
+	if err != nil {
+		return types.Student{}, err
+	}
+
+	return student, nil
+}
+
+func (b *Bolt) GetStudents(opts query.ListOptions) ([]types.Student, int, error) {
+	matched := []types.Student{}
+
+	err := b.Db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(studentsBucket).ForEach(func(k, v []byte) error {
+			var student types.Student
+			if err := json.Unmarshal(v, &student); err != nil {
+				return err
+			}
+			if opts.Filters.Matches(student) {
+				matched = append(matched, student)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	opts.ApplySort(matched)
+
+	return opts.Paginate(matched), len(matched), nil
+}
+
+func (b *Bolt) UpdateStudent(id int64, patch types.StudentPatch) (types.Student, error) {
+	student, err := b.GetStudentById(id)
+	if err != nil {
+		return types.Student{}, err
+	}
+
+	if patch.Name != nil {
+		student.Name = *patch.Name
+	}
+	if patch.Email != nil {
+		student.Email = *patch.Email
+	}
+	if patch.Age != nil {
+		student.Age = *patch.Age
+	}
+
+	err = b.Db.Update(func(tx *bolt.Tx) error {
+		data, err := json.Marshal(student)
+		if err != nil {
+			return err
+		}
+		return tx.Bucket(studentsBucket).Put(idKey(id), data)
+	})
+	if err != nil {
+		return types.Student{}, err
+	}
+
+	return student, nil
+}
+
+func (b *Bolt) DeleteStudent(id int64) error {
+	return b.Db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(studentsBucket)
+		if bucket.Get(idKey(id)) == nil {
+			return storage.ErrStudentNotFound
+		}
+		return bucket.Delete(idKey(id))
+	})
+}
+
+func (b *Bolt) Ping(ctx context.Context) error {
+	return b.Db.View(func(tx *bolt.Tx) error { return nil })
+}
@@ -0,0 +1,110 @@
+// Package memory provides an in-process Storage backend with no external
+// dependencies, registered under the "memory" driver name for use in tests.
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bishal05das/students-api/internal/config"
+	"github.com/bishal05das/students-api/internal/storage"
+	"github.com/bishal05das/students-api/internal/types"
+	"github.com/bishal05das/students-api/internal/utils/query"
+)
+
+func init() {
+	storage.Register("memory", func(cfg *config.Config) (storage.Storage, error) {
+		return New(), nil
+	})
+}
+
+type Memory struct {
+	mu       sync.Mutex
+	students map[int64]types.Student
+	nextId   int64
+}
+
+func New() *Memory {
+	return &Memory{
+		students: make(map[int64]types.Student),
+	}
+}
+
+func (m *Memory) CreateStudent(name string, email string, age int) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nextId++
+	m.students[m.nextId] = types.Student{Id: m.nextId, Name: name, Email: email, Age: age}
+
+	return m.nextId, nil
+}
+
+func (m *Memory) GetStudentById(id int64) (types.Student, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	student, ok := m.students[id]
+	if !ok {
+		return types.Student{}, storage.ErrStudentNotFound
+	}
+
+	return student, nil
+}
+
+func (m *Memory) GetStudents(opts query.ListOptions) ([]types.Student, int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	matched := []types.Student{}
+	for _, student := range m.students {
+		if opts.Filters.Matches(student) {
+			matched = append(matched, student)
+		}
+	}
+
+	opts.ApplySort(matched)
+
+	return opts.Paginate(matched), len(matched), nil
+}
+
+func (m *Memory) UpdateStudent(id int64, patch types.StudentPatch) (types.Student, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	student, ok := m.students[id]
+	if !ok {
+		return types.Student{}, storage.ErrStudentNotFound
+	}
+
+	if patch.Name != nil {
+		student.Name = *patch.Name
+	}
+	if patch.Email != nil {
+		student.Email = *patch.Email
+	}
+	if patch.Age != nil {
+		student.Age = *patch.Age
+	}
+
+	m.students[id] = student
+
+	return student, nil
+}
+
+func (m *Memory) DeleteStudent(id int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.students[id]; !ok {
+		return storage.ErrStudentNotFound
+	}
+
+	delete(m.students, id)
+
+	return nil
+}
+
+func (m *Memory) Ping(ctx context.Context) error {
+	return nil
+}
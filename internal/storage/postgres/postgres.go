@@ -0,0 +1,250 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bishal05das/students-api/internal/config"
+	"github.com/bishal05das/students-api/internal/storage"
+	"github.com/bishal05das/students-api/internal/types"
+	"github.com/bishal05das/students-api/internal/utils/query"
+	"github.com/lib/pq"
+)
+
+func init() {
+	storage.Register("postgres", func(cfg *config.Config) (storage.Storage, error) {
+		return New(cfg)
+	})
+}
+
+type Postgres struct {
+	Db *sql.DB
+}
+
+func New(cfg *config.Config) (*Postgres, error) {
+	db, err := sql.Open("postgres", cfg.Storage.DSN)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+
+	_, err = db.Exec(`
+	CREATE TABLE IF NOT EXISTS students (
+	id SERIAL PRIMARY KEY,
+	name TEXT,
+	email TEXT,
+	age INTEGER
+	)`)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = db.Exec(`
+	CREATE TABLE IF NOT EXISTS users (
+	id SERIAL PRIMARY KEY,
+	email TEXT UNIQUE,
+	password_hash TEXT,
+	role TEXT
+	)`)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Postgres{
+		Db: db,
+	}, nil
+}
+
+func (p *Postgres) CreateStudent(name string, email string, age int) (int64, error) {
+	var lastId int64
+
+	err := p.Db.QueryRow(
+		"INSERT INTO students (name, email, age) VALUES ($1, $2, $3) RETURNING id",
+		name, email, age,
+	).Scan(&lastId)
+	if err != nil {
+		return 0, err
+	}
+
+	return lastId, nil
+}
+
+func (p *Postgres) GetStudentById(id int64) (types.Student, error) {
+	var student types.Student
+
+	err := p.Db.QueryRow(
+		"SELECT id, name, email, age FROM students WHERE id = $1",
+		id,
+	).Scan(&student.Id, &student.Name, &student.Email, &student.Age)
+	if errors.Is(err, sql.ErrNoRows) {
+		return types.Student{}, storage.ErrStudentNotFound
+	}
+	if err != nil {
+		return types.Student{}, fmt.Errorf("query error: %w", err)
+	}
+
+	return student, nil
+}
+
+func (p *Postgres) GetStudents(opts query.ListOptions) ([]types.Student, int, error) {
+	where, args := studentsWhere(opts.Filters)
+
+	var total int
+	if err := p.Db.QueryRow("SELECT COUNT(*) FROM students"+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	q := fmt.Sprintf(
+		"SELECT id, name, email, age FROM students%s%s LIMIT $%d OFFSET $%d",
+		where, studentsOrderBy(opts.Sort), len(args)+1, len(args)+2,
+	)
+	rows, err := p.Db.Query(q, append(args, opts.Limit, opts.Offset())...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	students := []types.Student{}
+
+	for rows.Next() {
+		var student types.Student
+		if err := rows.Scan(&student.Id, &student.Name, &student.Email, &student.Age); err != nil {
+			return nil, 0, err
+		}
+		students = append(students, student)
+	}
+
+	return students, total, nil
+}
+
+// studentsWhere builds a WHERE clause (or "" if f has no filters set) and
+// its bound args for the students table, using numbered $n placeholders.
+func studentsWhere(f query.Filters) (string, []interface{}) {
+	var clauses []string
+	var args []interface{}
+
+	if f.Name != "" {
+		args = append(args, "%"+f.Name+"%")
+		clauses = append(clauses, fmt.Sprintf("name LIKE $%d", len(args)))
+	}
+	if f.MinAge != nil {
+		args = append(args, *f.MinAge)
+		clauses = append(clauses, fmt.Sprintf("age >= $%d", len(args)))
+	}
+	if f.MaxAge != nil {
+		args = append(args, *f.MaxAge)
+		clauses = append(clauses, fmt.Sprintf("age <= $%d", len(args)))
+	}
+
+	if len(clauses) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(clauses, " AND "), args
+}
+
+// studentsOrderBy builds an ORDER BY clause from sort, falling back to id
+// when sort is empty. Field names come from query's allowedSortFields
+// whitelist, so they're safe to interpolate directly.
+func studentsOrderBy(sort []query.SortField) string {
+	if len(sort) == 0 {
+		return " ORDER BY id"
+	}
+
+	parts := make([]string, len(sort))
+	for i, s := range sort {
+		dir := "ASC"
+		if s.Desc {
+			dir = "DESC"
+		}
+		parts[i] = fmt.Sprintf("%s %s", s.Field, dir)
+	}
+	return " ORDER BY " + strings.Join(parts, ", ")
+}
+
+func (p *Postgres) UpdateStudent(id int64, patch types.StudentPatch) (types.Student, error) {
+	student, err := p.GetStudentById(id)
+	if err != nil {
+		return types.Student{}, err
+	}
+
+	if patch.Name != nil {
+		student.Name = *patch.Name
+	}
+	if patch.Email != nil {
+		student.Email = *patch.Email
+	}
+	if patch.Age != nil {
+		student.Age = *patch.Age
+	}
+
+	_, err = p.Db.Exec(
+		"UPDATE students SET name = $1, email = $2, age = $3 WHERE id = $4",
+		student.Name, student.Email, student.Age, id,
+	)
+	if err != nil {
+		return types.Student{}, err
+	}
+
+	return student, nil
+}
+
+func (p *Postgres) DeleteStudent(id int64) error {
+	result, err := p.Db.Exec("DELETE FROM students WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return storage.ErrStudentNotFound
+	}
+
+	return nil
+}
+
+func (p *Postgres) Ping(ctx context.Context) error {
+	return p.Db.PingContext(ctx)
+}
+
+func (p *Postgres) CreateUser(email string, passwordHash string, role string) (int64, error) {
+	var lastId int64
+
+	err := p.Db.QueryRow(
+		"INSERT INTO users (email, password_hash, role) VALUES ($1, $2, $3) RETURNING id",
+		email, passwordHash, role,
+	).Scan(&lastId)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+			return 0, storage.ErrUserExists
+		}
+		return 0, err
+	}
+
+	return lastId, nil
+}
+
+func (p *Postgres) GetUserByEmail(email string) (types.User, error) {
+	var user types.User
+
+	err := p.Db.QueryRow(
+		"SELECT id, email, password_hash, role FROM users WHERE email = $1",
+		email,
+	).Scan(&user.Id, &user.Email, &user.PasswordHash, &user.Role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return types.User{}, storage.ErrUserNotFound
+	}
+	if err != nil {
+		return types.User{}, fmt.Errorf("query error: %w", err)
+	}
+
+	return user, nil
+}
@@ -0,0 +1,266 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bishal05das/students-api/internal/config"
+	"github.com/bishal05das/students-api/internal/storage"
+	"github.com/bishal05das/students-api/internal/types"
+	"github.com/bishal05das/students-api/internal/utils/query"
+	"github.com/mattn/go-sqlite3"
+)
+
+func init() {
+	storage.Register("sqlite", func(cfg *config.Config) (storage.Storage, error) {
+		return New(cfg)
+	})
+}
+
+type Sqlite struct {
+	Db *sql.DB
+}
+
+func New(cfg *config.Config) (*Sqlite, error) {
+	db, err := sql.Open("sqlite3", cfg.Storage.StoragePath)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = db.Exec(`
+	CREATE TABLE IF NOT EXISTS students (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	name TEXT,
+	email TEXT,
+	age INTEGER
+	)`)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = db.Exec(`
+	CREATE TABLE IF NOT EXISTS users (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	email TEXT UNIQUE,
+	password_hash TEXT,
+	role TEXT
+	)`)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Sqlite{
+		Db: db,
+	}, nil
+}
+
+func (s *Sqlite) CreateStudent(name string, email string, age int) (int64, error) {
+	stmt, err := s.Db.Prepare("INSERT INTO students (name, email, age) VALUES (?, ?, ?)")
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+
+	result, err := stmt.Exec(name, email, age)
+	if err != nil {
+		return 0, err
+	}
+
+	lastId, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	return lastId, nil
+}
+
+func (s *Sqlite) GetStudentById(id int64) (types.Student, error) {
+	stmt, err := s.Db.Prepare("SELECT id, name, email, age FROM students WHERE id = ? LIMIT 1")
+	if err != nil {
+		return types.Student{}, err
+	}
+	defer stmt.Close()
+
+	var student types.Student
+
+	err = stmt.QueryRow(id).Scan(&student.Id, &student.Name, &student.Email, &student.Age)
+	if errors.Is(err, sql.ErrNoRows) {
+		return types.Student{}, storage.ErrStudentNotFound
+	}
+	if err != nil {
+		return types.Student{}, fmt.Errorf("query error: %w", err)
+	}
+
+	return student, nil
+}
+
+func (s *Sqlite) GetStudents(opts query.ListOptions) ([]types.Student, int, error) {
+	where, args := studentsWhere(opts.Filters)
+
+	var total int
+	if err := s.Db.QueryRow("SELECT COUNT(*) FROM students"+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	q := "SELECT id, name, email, age FROM students" + where + studentsOrderBy(opts.Sort) + " LIMIT ? OFFSET ?"
+	rows, err := s.Db.Query(q, append(args, opts.Limit, opts.Offset())...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	students := []types.Student{}
+
+	for rows.Next() {
+		var student types.Student
+		if err := rows.Scan(&student.Id, &student.Name, &student.Email, &student.Age); err != nil {
+			return nil, 0, err
+		}
+		students = append(students, student)
+	}
+
+	return students, total, nil
+}
+
+// studentsWhere builds a WHERE clause (or "" if f has no filters set) and
+// its bound args for the students table, using ? placeholders.
+func studentsWhere(f query.Filters) (string, []interface{}) {
+	var clauses []string
+	var args []interface{}
+
+	if f.Name != "" {
+		clauses = append(clauses, "name LIKE ?")
+		args = append(args, "%"+f.Name+"%")
+	}
+	if f.MinAge != nil {
+		clauses = append(clauses, "age >= ?")
+		args = append(args, *f.MinAge)
+	}
+	if f.MaxAge != nil {
+		clauses = append(clauses, "age <= ?")
+		args = append(args, *f.MaxAge)
+	}
+
+	if len(clauses) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(clauses, " AND "), args
+}
+
+// studentsOrderBy builds an ORDER BY clause from sort, falling back to id
+// when sort is empty. Field names come from query's allowedSortFields
+// whitelist, so they're safe to interpolate directly.
+func studentsOrderBy(sort []query.SortField) string {
+	if len(sort) == 0 {
+		return " ORDER BY id"
+	}
+
+	parts := make([]string, len(sort))
+	for i, s := range sort {
+		dir := "ASC"
+		if s.Desc {
+			dir = "DESC"
+		}
+		parts[i] = fmt.Sprintf("%s %s", s.Field, dir)
+	}
+	return " ORDER BY " + strings.Join(parts, ", ")
+}
+
+// UpdateStudent applies a partial update to the student identified by id,
+// touching only the fields present in patch, and returns the updated row.
+func (s *Sqlite) UpdateStudent(id int64, patch types.StudentPatch) (types.Student, error) {
+	student, err := s.GetStudentById(id)
+	if err != nil {
+		return types.Student{}, err
+	}
+
+	if patch.Name != nil {
+		student.Name = *patch.Name
+	}
+	if patch.Email != nil {
+		student.Email = *patch.Email
+	}
+	if patch.Age != nil {
+		student.Age = *patch.Age
+	}
+
+	stmt, err := s.Db.Prepare("UPDATE students SET name = ?, email = ?, age = ? WHERE id = ?")
+	if err != nil {
+		return types.Student{}, err
+	}
+	defer stmt.Close()
+
+	if _, err := stmt.Exec(student.Name, student.Email, student.Age, id); err != nil {
+		return types.Student{}, err
+	}
+
+	return student, nil
+}
+
+func (s *Sqlite) DeleteStudent(id int64) error {
+	stmt, err := s.Db.Prepare("DELETE FROM students WHERE id = ?")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	result, err := stmt.Exec(id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return storage.ErrStudentNotFound
+	}
+
+	return nil
+}
+
+func (s *Sqlite) Ping(ctx context.Context) error {
+	return s.Db.PingContext(ctx)
+}
+
+func (s *Sqlite) CreateUser(email string, passwordHash string, role string) (int64, error) {
+	stmt, err := s.Db.Prepare("INSERT INTO users (email, password_hash, role) VALUES (?, ?, ?)")
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+
+	result, err := stmt.Exec(email, passwordHash, role)
+	if err != nil {
+		if sqliteErr, ok := err.(sqlite3.Error); ok && sqliteErr.Code == sqlite3.ErrConstraint {
+			return 0, storage.ErrUserExists
+		}
+		return 0, err
+	}
+
+	return result.LastInsertId()
+}
+
+func (s *Sqlite) GetUserByEmail(email string) (types.User, error) {
+	stmt, err := s.Db.Prepare("SELECT id, email, password_hash, role FROM users WHERE email = ? LIMIT 1")
+	if err != nil {
+		return types.User{}, err
+	}
+	defer stmt.Close()
+
+	var user types.User
+
+	err = stmt.QueryRow(email).Scan(&user.Id, &user.Email, &user.PasswordHash, &user.Role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return types.User{}, storage.ErrUserNotFound
+	}
+	if err != nil {
+		return types.User{}, fmt.Errorf("query error: %w", err)
+	}
+
+	return user, nil
+}
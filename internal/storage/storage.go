@@ -0,0 +1,75 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bishal05das/students-api/internal/config"
+	"github.com/bishal05das/students-api/internal/types"
+	"github.com/bishal05das/students-api/internal/utils/query"
+)
+
+// ErrStudentNotFound is returned by backends when a lookup, update or
+// delete targets a student id that does not exist, so handlers can tell
+// "not found" apart from a generic storage failure.
+var ErrStudentNotFound = errors.New("student not found")
+
+// ErrUserNotFound mirrors ErrStudentNotFound for the users subsystem.
+var ErrUserNotFound = errors.New("user not found")
+
+// ErrUserExists is returned by CreateUser when the email is already taken.
+var ErrUserExists = errors.New("user already exists")
+
+type Storage interface {
+	CreateStudent(name string, email string, age int) (int64, error)
+	GetStudentById(id int64) (types.Student, error)
+	// GetStudents returns the page of students matching opts, along with
+	// the total count of students matching its filters (ignoring Page and
+	// Limit), so callers can report total alongside the page of data.
+	GetStudents(opts query.ListOptions) ([]types.Student, int, error)
+	UpdateStudent(id int64, patch types.StudentPatch) (types.Student, error)
+	DeleteStudent(id int64) error
+	Ping(ctx context.Context) error
+}
+
+// UserStorage is implemented by backends that also support the users
+// subsystem used for authentication (sqlite and postgres, which are
+// table-backed; bolt and memory don't implement it). main checks for this
+// with a type assertion after storage.Open, the same optional-interface
+// pattern as io.ReaderFrom or http.Flusher.
+type UserStorage interface {
+	CreateUser(email string, passwordHash string, role string) (int64, error)
+	GetUserByEmail(email string) (types.User, error)
+}
+
+// Factory builds a Storage backend from config. Drivers register a Factory
+// under a name via Register, and Open picks one by cfg.Storage.Driver --
+// the same shape as database/sql's driver registry.
+type Factory func(cfg *config.Config) (Storage, error)
+
+var drivers = make(map[string]Factory)
+
+// Register makes a storage driver available under name. It is meant to be
+// called from a driver package's init function and panics on duplicate or
+// nil registration, mirroring database/sql.Register.
+func Register(name string, factory Factory) {
+	if factory == nil {
+		panic("storage: Register factory is nil for driver " + name)
+	}
+	if _, dup := drivers[name]; dup {
+		panic("storage: Register called twice for driver " + name)
+	}
+	drivers[name] = factory
+}
+
+// Open builds the Storage backend named by cfg.Storage.Driver. The caller
+// must blank-import the driver package it wants (e.g.
+// internal/storage/sqlite) so its init function has registered it.
+func Open(cfg *config.Config) (Storage, error) {
+	factory, ok := drivers[cfg.Storage.Driver]
+	if !ok {
+		return nil, fmt.Errorf("storage: unknown driver %q (forgotten import?)", cfg.Storage.Driver)
+	}
+	return factory(cfg)
+}
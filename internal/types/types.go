@@ -0,0 +1,46 @@
+package types
+
+type Student struct {
+	Id    int64  `json:"id"`
+	Name  string `json:"name" validate:"required"`
+	Email string `json:"email" validate:"required"`
+	Age   int    `json:"age" validate:"required"`
+}
+
+// StudentPatch carries a partial update for a student. Each field is a
+// pointer so the zero value can be distinguished from "not supplied" when
+// decoding a PATCH body.
+type StudentPatch struct {
+	Name  *string `json:"name,omitempty" validate:"omitempty"`
+	Email *string `json:"email,omitempty" validate:"omitempty,email"`
+	Age   *int    `json:"age,omitempty" validate:"omitempty,gt=0"`
+}
+
+// Role values a User can carry as its JWT role claim.
+const (
+	RoleAdmin   = "admin"
+	RoleStudent = "student"
+)
+
+type User struct {
+	Id           int64  `json:"id"`
+	Email        string `json:"email" validate:"required,email"`
+	PasswordHash string `json:"-"`
+	Role         string `json:"role" validate:"required,oneof=admin student"`
+}
+
+// RegisterRequest is the POST /api/auth/register body. Password is never
+// persisted as-is -- it's hashed into User.PasswordHash. There's no Role
+// field: self-registration always creates a RoleStudent user, since
+// letting a caller pick their own role would make every requireAdmin
+// endpoint one POST away from bypassed. Promoting a user to admin is an
+// out-of-band operation, not something this endpoint exposes.
+type RegisterRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required,min=8"`
+}
+
+type LoginRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+}
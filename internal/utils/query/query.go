@@ -0,0 +1,187 @@
+// Package query parses the pagination/filtering/sorting query parameters
+// shared by list endpoints into a typed ListOptions, so storage drivers
+// can push them down into their own query language (SQL, a scan filter,
+// ...) instead of handlers filtering in memory.
+package query
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bishal05das/students-api/internal/types"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// allowedSortFields is the whitelist of fields callers may sort students
+// by; anything else is a 400, not a passthrough to the storage layer.
+var allowedSortFields = map[string]bool{
+	"id":    true,
+	"name":  true,
+	"email": true,
+	"age":   true,
+}
+
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+type Filters struct {
+	Name   string
+	MinAge *int
+	MaxAge *int
+}
+
+type ListOptions struct {
+	Page    int
+	Limit   int
+	Sort    []SortField
+	Filters Filters
+}
+
+// Offset returns the zero-based row offset for Page/Limit.
+func (o ListOptions) Offset() int {
+	return (o.Page - 1) * o.Limit
+}
+
+// Parse reads page, limit, sort and filter query parameters off r and
+// validates them: page/limit must be positive integers, limit may not
+// exceed MaxLimit, and every sort field must be in allowedSortFields.
+func Parse(r *http.Request) (ListOptions, error) {
+	q := r.URL.Query()
+
+	page := 1
+	if v := q.Get("page"); v != "" {
+		p, err := strconv.Atoi(v)
+		if err != nil || p < 1 {
+			return ListOptions{}, fmt.Errorf("invalid page %q", v)
+		}
+		page = p
+	}
+
+	limit := DefaultLimit
+	if v := q.Get("limit"); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil || l < 1 {
+			return ListOptions{}, fmt.Errorf("invalid limit %q", v)
+		}
+		limit = l
+	}
+	if limit > MaxLimit {
+		return ListOptions{}, fmt.Errorf("limit %d exceeds max of %d", limit, MaxLimit)
+	}
+
+	var sort []SortField
+	if v := q.Get("sort"); v != "" {
+		for _, field := range strings.Split(v, ",") {
+			desc := strings.HasPrefix(field, "-")
+			name := strings.TrimPrefix(field, "-")
+			if !allowedSortFields[name] {
+				return ListOptions{}, fmt.Errorf("invalid sort field %q", name)
+			}
+			sort = append(sort, SortField{Field: name, Desc: desc})
+		}
+	}
+
+	filters := Filters{Name: q.Get("name")}
+
+	if v := q.Get("min_age"); v != "" {
+		age, err := strconv.Atoi(v)
+		if err != nil {
+			return ListOptions{}, fmt.Errorf("invalid min_age %q", v)
+		}
+		filters.MinAge = &age
+	}
+
+	if v := q.Get("max_age"); v != "" {
+		age, err := strconv.Atoi(v)
+		if err != nil {
+			return ListOptions{}, fmt.Errorf("invalid max_age %q", v)
+		}
+		filters.MaxAge = &age
+	}
+
+	return ListOptions{Page: page, Limit: limit, Sort: sort, Filters: filters}, nil
+}
+
+// Matches reports whether s satisfies f. Name is a case-insensitive
+// substring match; MinAge/MaxAge are inclusive bounds when set.
+func (f Filters) Matches(s types.Student) bool {
+	if f.Name != "" && !strings.Contains(strings.ToLower(s.Name), strings.ToLower(f.Name)) {
+		return false
+	}
+	if f.MinAge != nil && s.Age < *f.MinAge {
+		return false
+	}
+	if f.MaxAge != nil && s.Age > *f.MaxAge {
+		return false
+	}
+	return true
+}
+
+// ApplySort orders students in place according to o.Sort, falling back to
+// ascending id when no sort fields were given. It's for backends (bolt,
+// memory) that can't push sorting down into a query language.
+func (o ListOptions) ApplySort(students []types.Student) {
+	fields := o.Sort
+	if len(fields) == 0 {
+		fields = []SortField{{Field: "id"}}
+	}
+
+	sort.SliceStable(students, func(i, j int) bool {
+		for _, f := range fields {
+			c := compareStudents(students[i], students[j], f.Field)
+			if c == 0 {
+				continue
+			}
+			if f.Desc {
+				return c > 0
+			}
+			return c < 0
+		}
+		return false
+	})
+}
+
+func compareStudents(a, b types.Student, field string) int {
+	switch field {
+	case "name":
+		return strings.Compare(a.Name, b.Name)
+	case "email":
+		return strings.Compare(a.Email, b.Email)
+	case "age":
+		return a.Age - b.Age
+	default:
+		switch {
+		case a.Id < b.Id:
+			return -1
+		case a.Id > b.Id:
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+// Paginate slices students down to the requested page, for backends that
+// load the full filtered set into memory before paginating.
+func (o ListOptions) Paginate(students []types.Student) []types.Student {
+	start := o.Offset()
+	if start >= len(students) {
+		return []types.Student{}
+	}
+
+	end := start + o.Limit
+	if end > len(students) {
+		end = len(students)
+	}
+
+	return students[start:end]
+}
@@ -0,0 +1,171 @@
+package query
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bishal05das/students-api/internal/types"
+)
+
+func parse(t *testing.T, rawQuery string) (ListOptions, error) {
+	t.Helper()
+	r := httptest.NewRequest("GET", "/api/students?"+rawQuery, nil)
+	return Parse(r)
+}
+
+func TestParse_Defaults(t *testing.T) {
+	opts, err := parse(t, "")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if opts.Page != 1 || opts.Limit != DefaultLimit {
+		t.Fatalf("opts = %+v, want Page=1 Limit=%d", opts, DefaultLimit)
+	}
+}
+
+func TestParse_PageAndLimit(t *testing.T) {
+	opts, err := parse(t, "page=2&limit=5")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if opts.Page != 2 || opts.Limit != 5 {
+		t.Fatalf("opts = %+v, want Page=2 Limit=5", opts)
+	}
+	if got := opts.Offset(); got != 5 {
+		t.Fatalf("Offset() = %d, want 5", got)
+	}
+}
+
+func TestParse_InvalidPage(t *testing.T) {
+	for _, v := range []string{"0", "-1", "abc"} {
+		if _, err := parse(t, "page="+v); err == nil {
+			t.Errorf("page=%q: expected an error, got nil", v)
+		}
+	}
+}
+
+func TestParse_InvalidLimit(t *testing.T) {
+	for _, v := range []string{"0", "-1", "abc"} {
+		if _, err := parse(t, "limit="+v); err == nil {
+			t.Errorf("limit=%q: expected an error, got nil", v)
+		}
+	}
+}
+
+func TestParse_LimitExceedsMax(t *testing.T) {
+	if _, err := parse(t, "limit=1000"); err == nil {
+		t.Fatal("expected an error for a limit over MaxLimit, got nil")
+	}
+}
+
+func TestParse_SortFields(t *testing.T) {
+	opts, err := parse(t, "sort=name,-age")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	want := []SortField{{Field: "name"}, {Field: "age", Desc: true}}
+	if len(opts.Sort) != len(want) {
+		t.Fatalf("Sort = %+v, want %+v", opts.Sort, want)
+	}
+	for i := range want {
+		if opts.Sort[i] != want[i] {
+			t.Fatalf("Sort[%d] = %+v, want %+v", i, opts.Sort[i], want[i])
+		}
+	}
+}
+
+func TestParse_InvalidSortField(t *testing.T) {
+	if _, err := parse(t, "sort=nickname"); err == nil {
+		t.Fatal("expected an error for an unknown sort field, got nil")
+	}
+}
+
+func TestParse_Filters(t *testing.T) {
+	opts, err := parse(t, "name=ada&min_age=10&max_age=20")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if opts.Filters.Name != "ada" || opts.Filters.MinAge == nil || *opts.Filters.MinAge != 10 || opts.Filters.MaxAge == nil || *opts.Filters.MaxAge != 20 {
+		t.Fatalf("Filters = %+v, want Name=ada MinAge=10 MaxAge=20", opts.Filters)
+	}
+}
+
+func TestParse_InvalidAgeFilters(t *testing.T) {
+	if _, err := parse(t, "min_age=abc"); err == nil {
+		t.Error("min_age=abc: expected an error, got nil")
+	}
+	if _, err := parse(t, "max_age=abc"); err == nil {
+		t.Error("max_age=abc: expected an error, got nil")
+	}
+}
+
+func TestFilters_Matches(t *testing.T) {
+	student := types.Student{Name: "Ada Lovelace", Age: 30}
+
+	cases := []struct {
+		name string
+		f    Filters
+		want bool
+	}{
+		{"no filters", Filters{}, true},
+		{"name substring, case-insensitive", Filters{Name: "ada"}, true},
+		{"name no match", Filters{Name: "grace"}, false},
+		{"min age satisfied", Filters{MinAge: intPtr(30)}, true},
+		{"min age not satisfied", Filters{MinAge: intPtr(31)}, false},
+		{"max age satisfied", Filters{MaxAge: intPtr(30)}, true},
+		{"max age not satisfied", Filters{MaxAge: intPtr(29)}, false},
+	}
+
+	for _, c := range cases {
+		if got := c.f.Matches(student); got != c.want {
+			t.Errorf("%s: Matches() = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestListOptions_ApplySort_DefaultsToId(t *testing.T) {
+	students := []types.Student{{Id: 2}, {Id: 1}, {Id: 3}}
+	ListOptions{}.ApplySort(students)
+
+	if students[0].Id != 1 || students[1].Id != 2 || students[2].Id != 3 {
+		t.Fatalf("students = %+v, want sorted by id ascending", students)
+	}
+}
+
+func TestListOptions_ApplySort_ByFieldDescending(t *testing.T) {
+	students := []types.Student{{Name: "Ada"}, {Name: "Grace"}, {Name: "Bob"}}
+	ListOptions{Sort: []SortField{{Field: "name", Desc: true}}}.ApplySort(students)
+
+	if students[0].Name != "Grace" || students[1].Name != "Bob" || students[2].Name != "Ada" {
+		t.Fatalf("students = %+v, want descending by name", students)
+	}
+}
+
+func TestListOptions_Paginate(t *testing.T) {
+	students := []types.Student{{Id: 1}, {Id: 2}, {Id: 3}, {Id: 4}, {Id: 5}}
+
+	page := ListOptions{Page: 2, Limit: 2}.Paginate(students)
+	if len(page) != 2 || page[0].Id != 3 || page[1].Id != 4 {
+		t.Fatalf("page 2 limit 2 = %+v, want ids [3 4]", page)
+	}
+}
+
+func TestListOptions_Paginate_PastEnd(t *testing.T) {
+	students := []types.Student{{Id: 1}, {Id: 2}}
+
+	page := ListOptions{Page: 5, Limit: 2}.Paginate(students)
+	if page == nil || len(page) != 0 {
+		t.Fatalf("page past the end = %+v, want a non-nil empty slice", page)
+	}
+}
+
+func TestListOptions_Paginate_PartialLastPage(t *testing.T) {
+	students := []types.Student{{Id: 1}, {Id: 2}, {Id: 3}}
+
+	page := ListOptions{Page: 2, Limit: 2}.Paginate(students)
+	if len(page) != 1 || page[0].Id != 3 {
+		t.Fatalf("page 2 limit 2 of 3 = %+v, want ids [3]", page)
+	}
+}
+
+func intPtr(v int) *int { return &v }